@@ -0,0 +1,55 @@
+package mint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+const (
+	ModuleName                 = types.ModuleName
+	DefaultParamspace          = types.DefaultParamspace
+	StoreKey                   = types.StoreKey
+	QuerierRoute               = types.QuerierRoute
+	RouterKey                  = types.RouterKey
+	MintingModeHalving         = types.MintingModeHalving
+	MintingModeTarget          = types.MintingModeTarget
+	ProposalTypeScheduleChange = types.ProposalTypeScheduleChange
+
+	EventTypeMint               = types.EventTypeMint
+	AttributeKeyBondedRatio     = types.AttributeKeyBondedRatio
+	AttributeKeyInflation       = types.AttributeKeyInflation
+	AttributeKeyCycle           = types.AttributeKeyCycle
+	AttributeKeyRemainingSupply = types.AttributeKeyRemainingSupply
+)
+
+var (
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
+	NewMinter                 = types.NewMinter
+	InitialMinter             = types.InitialMinter
+	DefaultInitialMinter      = types.DefaultInitialMinter
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	ValidateGenesis           = types.ValidateGenesis
+	NewQueryAPYResponse       = types.NewQueryAPYResponse
+	NewScheduleSegment        = types.NewScheduleSegment
+	NewScheduleChangeProposal = types.NewScheduleChangeProposal
+	RegisterInvariants        = keeper.RegisterInvariants
+)
+
+type (
+	Keeper                 = keeper.Keeper
+	Params                 = types.Params
+	Minter                 = types.Minter
+	GenesisState           = types.GenesisState
+	StakingKeeper          = types.StakingKeeper
+	SupplyKeeper           = types.SupplyKeeper
+	LockedSupplyKeeper     = types.LockedSupplyKeeper
+	VestingKeeper          = types.VestingKeeper
+	QueryAPYResponse       = types.QueryAPYResponse
+	ScheduleSegment        = types.ScheduleSegment
+	ScheduleChangeProposal = types.ScheduleChangeProposal
+)
@@ -0,0 +1,116 @@
+package mint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker mints new tokens for the previous block and splits them
+// between the staking fee collector and the community pool module account,
+// per Params.StakingRewardsFraction/CommunityPoolFraction. The active mint
+// schedule is selected by Params.MintingMode: MintingModeHalving follows the
+// fixed-cap UnitCoin/Decrease/BlocksPerUnit curve via Keeper.CalculateCoin,
+// while MintingModeTarget mints against a rolling annual provisions figure
+// recomputed whenever total supply reaches the minter's TargetSupply.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	defer telemetry.ModuleMeasureSince(ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	params := k.GetParams(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+
+	var (
+		mintedCoin       sdk.Coin
+		cycle            int64
+		annualProvisions sdk.Dec
+	)
+	switch params.MintingMode {
+	case MintingModeTarget:
+		mintedCoin, annualProvisions = mintTargetSupply(ctx, k, params, bondedRatio)
+	default:
+		mintedCoin = sdk.NewCoin(params.MintDenom, k.CalculateCoin(ctx, params))
+		cycle = k.GetActiveCycle(ctx, params)
+		annualProvisions = sdk.NewDecFromInt(mintedCoin.Amount).MulInt64(int64(params.BlocksPerYear))
+	}
+
+	mintedCoins := sdk.NewCoins(mintedCoin)
+	if err := k.MintCoins(ctx, mintedCoins); err != nil {
+		panic(err)
+	}
+
+	stakingRewards, communityPoolShare := splitMintedCoins(mintedCoin, params)
+
+	if err := k.AddCollectedFees(ctx, stakingRewards); err != nil {
+		panic(err)
+	}
+
+	if err := k.SendCoinsToCommunityPool(ctx, communityPoolShare); err != nil {
+		panic(err)
+	}
+
+	remainingSupply := k.GetNowTotalSupply(ctx)
+
+	// These fixed-cap params are denominated in base units (e.g. 21e6 * 1e18),
+	// which routinely overflow int64; guard each conversion so telemetry never
+	// panics BeginBlocker.
+	if annualProvisionsInt := annualProvisions.TruncateInt(); annualProvisionsInt.IsInt64() {
+		telemetry.SetGauge(float32(annualProvisionsInt.Int64()), ModuleName, "annual_provisions")
+	}
+	if mintedCoin.Amount.IsInt64() {
+		telemetry.SetGauge(float32(mintedCoin.Amount.Int64()), ModuleName, "unit_coin")
+	}
+	if remainingSupply.IsInt64() {
+		telemetry.SetGauge(float32(remainingSupply.Int64()), ModuleName, "now_total_supply")
+	}
+	telemetry.SetGauge(float32(bondedRatio.MustFloat64()), ModuleName, "bonded_ratio")
+
+	if !mintedCoin.Amount.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeMint,
+				sdk.NewAttribute(AttributeKeyBondedRatio, bondedRatio.String()),
+				sdk.NewAttribute(AttributeKeyInflation, k.GetMinter(ctx).Inflation.String()),
+				sdk.NewAttribute(AttributeKeyCycle, fmt.Sprintf("%d", cycle)),
+				sdk.NewAttribute(AttributeKeyRemainingSupply, remainingSupply.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			),
+		)
+	}
+}
+
+// splitMintedCoins divides a block's minted coins between the staking fee
+// collector and the community pool, per Params.StakingRewardsFraction.
+// communityPoolShare always gets whatever stakingRewards' truncation leaves
+// behind, rather than truncating CommunityPoolFraction's own share
+// independently, so stakingRewards.Add(communityPoolShare) always equals
+// mintedCoins exactly and no minted coin is ever stranded in the mint
+// module account.
+func splitMintedCoins(mintedCoin sdk.Coin, params Params) (stakingRewards, communityPoolShare sdk.Coins) {
+	stakingRewards = sdk.NewCoins(
+		sdk.NewCoin(params.MintDenom, params.StakingRewardsFraction.MulInt(mintedCoin.Amount).TruncateInt()),
+	)
+	communityPoolShare = sdk.NewCoins(mintedCoin).Sub(stakingRewards)
+	return stakingRewards, communityPoolShare
+}
+
+// mintTargetSupply implements MintingModeTarget: if total supply has caught
+// up with the minter's TargetSupply, it rolls the inflation rate and target
+// supply forward before minting the current block's provision. It returns
+// the minted coin and the minter's (possibly just-rolled) annual provisions,
+// for use in BeginBlocker's telemetry gauges.
+func mintTargetSupply(ctx sdk.Context, k Keeper, params Params, bondedRatio sdk.Dec) (sdk.Coin, sdk.Dec) {
+	minter := k.GetMinter(ctx)
+	totalSupply := k.GetTotalSupply(ctx, params.MintDenom)
+
+	if totalSupply.GTE(minter.TargetSupply) {
+		effectiveSupply := k.GetEffectiveSupply(ctx, params.MintDenom)
+		minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(effectiveSupply)
+		minter.TargetSupply = totalSupply.Add(minter.AnnualProvisions.TruncateInt())
+		k.SetMinter(ctx, minter)
+	}
+
+	return minter.BlockProvision(params), minter.AnnualProvisions
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// GetQueryCmd returns the CLI query commands for the mint module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	mintQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the minting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	mintQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryInflation(cdc),
+		GetCmdQueryAnnualProvisions(cdc),
+		GetCmdQueryStakingAPY(cdc),
+	)...)
+
+	return mintQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current mint
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current minting parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryInflation implements a command to return the current minting
+// inflation rate.
+func GetCmdQueryInflation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current minting inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInflation), nil)
+			if err != nil {
+				return err
+			}
+
+			var inflation sdk.Dec
+			cdc.MustUnmarshalJSON(res, &inflation)
+			return cliCtx.PrintOutput(inflation)
+		},
+	}
+}
+
+// GetCmdQueryAnnualProvisions implements a command to return the current
+// minting annual provisions.
+func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current minting annual provisions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAnnualProvisions), nil)
+			if err != nil {
+				return err
+			}
+
+			var annualProvisions sdk.Dec
+			cdc.MustUnmarshalJSON(res, &annualProvisions)
+			return cliCtx.PrintOutput(annualProvisions)
+		},
+	}
+}
+
+// GetCmdQueryStakingAPY implements a command to return the raw and effective
+// annualized staking yield.
+func GetCmdQueryStakingAPY(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "staking-apy",
+		Short: "Query the current annualized staking yield (raw and vesting-effective)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStakingAPY), nil)
+			if err != nil {
+				return err
+			}
+
+			var apy types.QueryAPYResponse
+			cdc.MustUnmarshalJSON(res, &apy)
+			return cliCtx.PrintOutput(apy)
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new mint genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, ak SupplyKeeper, data GenesisState) {
+	keeper.SetMinter(ctx, data.Minter)
+	keeper.SetParams(ctx, data.Params)
+
+	for _, segment := range data.ScheduleSegments {
+		keeper.SetScheduleSegment(ctx, segment)
+	}
+
+	if addr := ak.GetModuleAddress(ModuleName); addr == nil {
+		panic("the mint module account has not been set")
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	minter := keeper.GetMinter(ctx)
+	params := keeper.GetParams(ctx)
+	scheduleSegments := keeper.GetAllScheduleSegments(ctx)
+	return NewGenesisState(minter, params, scheduleSegments)
+}
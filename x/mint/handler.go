@@ -0,0 +1,23 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// NewScheduleChangeProposalHandler creates a governance Handler for a
+// ScheduleChangeProposal. Wire it into the app's gov Router under RouterKey
+// (e.g. govRouter.AddRoute(mint.RouterKey, mint.NewScheduleChangeProposalHandler(mintKeeper))).
+func NewScheduleChangeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.ScheduleChangeProposal:
+			return keeper.HandleScheduleChangeProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized mint proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// RegisterInvariants registers all mint invariants on the given InvariantRegistry.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "non-negative-remaining-supply", NonNegativeRemainingSupplyInvariant(k))
+}
+
+// NonNegativeRemainingSupplyInvariant checks that the halving schedule's
+// tracked remaining supply (NowTotalSupply) never goes negative, and that
+// cumulative minted supply never exceeds params.TotalSupply. This guards
+// against the arithmetic edge case in CalculateCoin's third branch, where
+// NowTotalSupply is zeroed and unitCoin is capped at the remaining supply it
+// held just before.
+func NonNegativeRemainingSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		nowTotalSupply := k.GetNowTotalSupply(ctx)
+		mintedSupply := params.TotalSupply.Sub(nowTotalSupply)
+
+		broken := nowTotalSupply.IsNegative() || mintedSupply.GT(params.TotalSupply)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "non-negative-remaining-supply",
+			fmt.Sprintf(
+				"\tnow total supply: %s\n\tcumulative minted supply: %s\n\tparams total supply: %s\n",
+				nowTotalSupply, mintedSupply, params.TotalSupply,
+			),
+		), broken
+	}
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNonNegativeRemainingSupplyInvariant(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	invariant := NonNegativeRemainingSupplyInvariant(k)
+
+	params := k.GetParams(ctx)
+	k.SetNowTotalSupply(ctx, params.TotalSupply)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	k.SetNowTotalSupply(ctx, sdk.NewInt(-1))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
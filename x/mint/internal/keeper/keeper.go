@@ -13,18 +13,25 @@ import (
 
 // Keeper of the mint store
 type Keeper struct {
-	cdc              *codec.Codec
-	storeKey         sdk.StoreKey
-	paramSpace       params.Subspace
-	sk               types.StakingKeeper
-	supplyKeeper     types.SupplyKeeper
-	feeCollectorName string
+	cdc                *codec.Codec
+	storeKey           sdk.StoreKey
+	paramSpace         params.Subspace
+	sk                 types.StakingKeeper
+	supplyKeeper       types.SupplyKeeper
+	feeCollectorName   string
+	communityPoolName  string
+	lockedSupplyKeeper types.LockedSupplyKeeper
+	vestingKeeper      types.VestingKeeper
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. lockedSupplyKeeper is an
+// optional dependency (e.g. an enterprise/vesting module) used to exclude
+// locked supply from BondedRatio and inflation-based provisions; existing
+// chains that don't pass one are unaffected.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string, communityPoolName string,
+	lockedSupplyKeeper ...types.LockedSupplyKeeper,
 ) Keeper {
 
 	// ensure mint module account is set
@@ -32,14 +39,29 @@ func NewKeeper(
 		panic("the mint module account has not been set")
 	}
 
-	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
-		sk:               sk,
-		supplyKeeper:     supplyKeeper,
-		feeCollectorName: feeCollectorName,
+	keeper := Keeper{
+		cdc:               cdc,
+		storeKey:          key,
+		paramSpace:        paramSpace.WithKeyTable(types.ParamKeyTable()),
+		sk:                sk,
+		supplyKeeper:      supplyKeeper,
+		feeCollectorName:  feeCollectorName,
+		communityPoolName: communityPoolName,
 	}
+
+	if len(lockedSupplyKeeper) > 0 {
+		keeper.lockedSupplyKeeper = lockedSupplyKeeper[0]
+	}
+
+	return keeper
+}
+
+// SetVestingKeeper wires an optional VestingKeeper dependency used by
+// EffectiveBondedRatio to exclude vesting/insurance-locked tokens from the
+// bonded ratio used in APY reporting. Chains that don't call this are
+// unaffected.
+func (k *Keeper) SetVestingKeeper(vk types.VestingKeeper) {
+	k.vestingKeeper = vk
 }
 
 //______________________________________________________________________
@@ -90,9 +112,118 @@ func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
 }
 
 // BondedRatio implements an alias call to the underlying staking keeper's
-// BondedRatio to be used in BeginBlocker.
+// BondedRatio to be used in BeginBlocker. When a LockedSupplyKeeper is wired
+// and Params.ExcludeLockedFromInflation is set, locked/enterprise supply is
+// excluded from the denominator so it does not dilute rewards for real
+// stakers: bonded / (stakingSupply - locked).
 func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
-	return k.sk.BondedRatio(ctx)
+	params := k.GetParams(ctx)
+	if k.lockedSupplyKeeper == nil || !params.ExcludeLockedFromInflation {
+		return k.sk.BondedRatio(ctx)
+	}
+
+	stakingSupply := k.sk.StakingTokenSupply(ctx)
+	locked := k.lockedSupplyKeeper.GetTotalLockedSupply(ctx, params.MintDenom)
+	effectiveSupply := stakingSupply.Sub(locked)
+	if !effectiveSupply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	bondedTokens := k.sk.BondedRatio(ctx).MulInt(stakingSupply)
+	return bondedTokens.QuoInt(effectiveSupply)
+}
+
+// EffectiveBondedRatio returns the fraction of the staking token supply that
+// is bonded by stakers whose tokens are not locked up in vesting/insurance
+// accounts (see VestingKeeper). Unlike BondedRatio, whose denominator is
+// adjusted for the goal-bonded inflation curve, this is used purely for
+// honest APY reporting: vesting-locked tokens count toward StakingTokenSupply
+// but never earn rewards, so they should not inflate the apparent yield.
+func (k Keeper) EffectiveBondedRatio(ctx sdk.Context) sdk.Dec {
+	stakingSupply := k.sk.StakingTokenSupply(ctx)
+	if !stakingSupply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	bondedTokens := k.sk.BondedRatio(ctx).MulInt(stakingSupply)
+	if k.vestingKeeper != nil {
+		denom := k.GetParams(ctx).MintDenom
+		vestingLocked := sdk.NewDecFromInt(k.vestingKeeper.TotalVestingLocked(ctx, denom))
+		bondedTokens = bondedTokens.Sub(vestingLocked)
+		if bondedTokens.IsNegative() {
+			bondedTokens = sdk.ZeroDec()
+		}
+	}
+
+	return bondedTokens.QuoInt(stakingSupply)
+}
+
+// GetBlockProvision returns the amount minted for the current block under
+// whichever MintingMode is active. It is purely read-only: MintingModeTarget
+// only reads the minter (it does not roll it), and MintingModeHalving peeks
+// CalculateCoin's unit coin via PeekCoin instead of calling CalculateCoin
+// itself, which mutates NowTotalSupply. Safe to call from queries.
+func (k Keeper) GetBlockProvision(ctx sdk.Context, params types.Params) sdk.Int {
+	if params.MintingMode == types.MintingModeTarget {
+		return k.GetMinter(ctx).BlockProvision(params).Amount
+	}
+	return k.PeekCoin(ctx, params)
+}
+
+// QueryStakingAPY computes the current annualized yield actually delivered
+// to a bonded staker: (BlocksPerYear * blockProvision * StakingRewardsFraction)
+// / bondedTokens. EffectiveAPY divides the same annual rewards by
+// EffectiveBondedRatio's vesting-excluded bonded tokens instead.
+func (k Keeper) QueryStakingAPY(ctx sdk.Context) types.QueryAPYResponse {
+	params := k.GetParams(ctx)
+
+	annualStakingRewards := sdk.NewDecFromInt(k.GetBlockProvision(ctx, params)).
+		MulInt64(int64(params.BlocksPerYear)).
+		Mul(params.StakingRewardsFraction)
+
+	stakingSupply := k.sk.StakingTokenSupply(ctx)
+	bondedTokens := k.sk.BondedRatio(ctx).MulInt(stakingSupply)
+	effectiveBondedTokens := k.EffectiveBondedRatio(ctx).MulInt(stakingSupply)
+
+	apy := sdk.ZeroDec()
+	if bondedTokens.IsPositive() {
+		apy = annualStakingRewards.Quo(bondedTokens)
+	}
+
+	effectiveAPY := sdk.ZeroDec()
+	if effectiveBondedTokens.IsPositive() {
+		effectiveAPY = annualStakingRewards.Quo(effectiveBondedTokens)
+	}
+
+	return types.NewQueryAPYResponse(apy, effectiveAPY)
+}
+
+// GetTotalSupply returns the current total supply of the given denom, as
+// tracked by the supply keeper. Used by MintingModeTarget to decide when to
+// roll the inflation schedule.
+func (k Keeper) GetTotalSupply(ctx sdk.Context, denom string) sdk.Int {
+	return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+}
+
+// GetEffectiveSupply returns the total supply of the given denom minus any
+// locked/enterprise supply reported by the LockedSupplyKeeper, when one is
+// wired and Params.ExcludeLockedFromInflation is set. It is the base used
+// for inflation-based mint provisions (see mintTargetSupply).
+func (k Keeper) GetEffectiveSupply(ctx sdk.Context, denom string) sdk.Int {
+	totalSupply := k.GetTotalSupply(ctx, denom)
+
+	params := k.GetParams(ctx)
+	if k.lockedSupplyKeeper == nil || !params.ExcludeLockedFromInflation {
+		return totalSupply
+	}
+
+	locked := k.lockedSupplyKeeper.GetTotalLockedSupply(ctx, denom)
+	effectiveSupply := totalSupply.Sub(locked)
+	if !effectiveSupply.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return effectiveSupply
 }
 
 // MintCoins implements an alias call to the underlying supply keeper's
@@ -112,25 +243,80 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
 
-// CalculateCoin calculate coins per month with fixed total
-// CalculateCoin to be used in BeginBlocker.
-func (k Keeper) CalculateCoin(ctx sdk.Context, params types.Params) sdk.Int {
+// SendCoinsToCommunityPool sends minted coins from the mint module account to
+// the community pool module account, to be used in BeginBlocker alongside
+// AddCollectedFees when splitting emissions per Params.CommunityPoolFraction.
+func (k Keeper) SendCoinsToCommunityPool(ctx sdk.Context, amt sdk.Coins) error {
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.communityPoolName, amt)
+}
+
+// decayedUnitCoin returns the halving-curve unit coin for the current block
+// height, following params.UnitCoin/Decrease/BlocksPerUnit from genesis
+// unless a governance-approved ScheduleChangeProposal has recorded a
+// ScheduleSegment active at the current height (see GetActiveScheduleSegment),
+// in which case emissions follow that segment's curve from its StartHeight
+// forward. It does not read or write NowTotalSupply and is safe to call from
+// queries.
+func (k Keeper) decayedUnitCoin(ctx sdk.Context, params types.Params) sdk.Int {
+	height := ctx.BlockHeight()
+
+	startHeight := int64(0)
+	blocksPerUnit := params.BlocksPerUnit
+	decrease := params.Decrease
+	unitCoin := params.UnitCoin
+
+	if segment, found := k.GetActiveScheduleSegment(ctx, height); found {
+		startHeight = segment.StartHeight
+		blocksPerUnit = segment.BlocksPerUnit
+		decrease = segment.Decrease
+		unitCoin = segment.UnitCoin
+	}
+
 	var (
-		unitCoin = params.UnitCoin
-		count    = int64(0)
-		cycle    = ctx.BlockHeight() / params.BlocksPerUnit
+		count = int64(0)
+		cycle = (height - startHeight) / blocksPerUnit
 	)
 	for {
 		if count >= cycle {
 			break
 		}
-		unitCoin = unitCoin.Mul(params.Decrease).QuoRaw(100) //Get rewards in the current period
+		unitCoin = unitCoin.Mul(decrease).QuoRaw(100) //Get rewards in the current period
 		count++
 	}
 
+	return unitCoin
+}
+
+// PeekCoin returns the amount CalculateCoin would mint for the current block
+// without mutating NowTotalSupply, for use by read-only callers such as
+// GetBlockProvision.
+func (k Keeper) PeekCoin(ctx sdk.Context, params types.Params) sdk.Int {
+	unitCoin := k.decayedUnitCoin(ctx, params)
+	if unitCoin.LTE(sdk.ZeroInt()) {
+		return sdk.ZeroInt()
+	}
+
+	nowTotalSupply := k.GetNowTotalSupply(ctx)
+	if nowTotalSupply.LT(sdk.ZeroInt()) {
+		return unitCoin
+	} else if nowTotalSupply.GT(sdk.ZeroInt()) && nowTotalSupply.GTE(unitCoin) {
+		return unitCoin
+	} else if nowTotalSupply.GT(sdk.ZeroInt()) && nowTotalSupply.LT(unitCoin) {
+		return nowTotalSupply
+	}
+	return sdk.ZeroInt()
+}
+
+// CalculateCoin calculate coins per month with fixed total
+// CalculateCoin to be used in BeginBlocker. It follows the same halving
+// curve as PeekCoin, but additionally debits the minted amount from
+// NowTotalSupply; use PeekCoin instead when a read-only amount is needed.
+func (k Keeper) CalculateCoin(ctx sdk.Context, params types.Params) sdk.Int {
+	unitCoin := k.decayedUnitCoin(ctx, params)
 	if unitCoin.LTE(sdk.ZeroInt()) {
 		return sdk.ZeroInt()
 	}
+
 	nowTotalSupply := k.GetNowTotalSupply(ctx)
 	if nowTotalSupply.LT(sdk.ZeroInt()) {
 		k.SetNowTotalSupply(ctx, params.TotalSupply.Sub(unitCoin))
@@ -146,6 +332,23 @@ func (k Keeper) CalculateCoin(ctx sdk.Context, params types.Params) sdk.Int {
 	return unitCoin
 }
 
+// GetActiveCycle returns the halving cycle count for the current block
+// height under whichever schedule segment is active (see
+// GetActiveScheduleSegment), for use alongside CalculateCoin in telemetry
+// and the EventTypeMint event.
+func (k Keeper) GetActiveCycle(ctx sdk.Context, params types.Params) int64 {
+	height := ctx.BlockHeight()
+
+	startHeight := int64(0)
+	blocksPerUnit := params.BlocksPerUnit
+	if segment, found := k.GetActiveScheduleSegment(ctx, height); found {
+		startHeight = segment.StartHeight
+		blocksPerUnit = segment.BlocksPerUnit
+	}
+
+	return (height - startHeight) / blocksPerUnit
+}
+
 func (k Keeper) GetNowTotalSupply(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
 	if !store.Has([]byte(types.NowTotalSupply)) {
@@ -161,4 +364,4 @@ func (k Keeper) GetNowTotalSupply(ctx sdk.Context) sdk.Int {
 func (k Keeper) SetNowTotalSupply(ctx sdk.Context, supply sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte(types.NowTotalSupply), k.cdc.MustMarshalBinaryBare(supply))
-}
\ No newline at end of file
+}
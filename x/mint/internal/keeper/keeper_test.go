@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestEffectiveBondedRatio_ExcludesVestingLocked(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	k.sk = mockStakingKeeper{
+		stakingTokenSupply: sdk.NewInt(100),
+		bondedRatio:        sdk.NewDecWithPrec(50, 2), // 50 bonded
+	}
+	k.vestingKeeper = mockVestingKeeper{vestingLocked: sdk.NewInt(20)}
+
+	// (50 bonded - 20 vesting-locked) / 100 staking supply = 0.3
+	require.True(t, k.EffectiveBondedRatio(ctx).Equal(sdk.NewDecWithPrec(30, 2)))
+}
+
+func TestEffectiveBondedRatio_FloorsAtZeroWhenVestingExceedsBonded(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	k.sk = mockStakingKeeper{
+		stakingTokenSupply: sdk.NewInt(100),
+		bondedRatio:        sdk.NewDecWithPrec(10, 2), // 10 bonded
+	}
+	k.vestingKeeper = mockVestingKeeper{vestingLocked: sdk.NewInt(50)}
+
+	require.True(t, k.EffectiveBondedRatio(ctx).IsZero())
+}
+
+func TestQueryStakingAPY(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MintingMode = types.MintingModeTarget
+	params.StakingRewardsFraction = sdk.NewDecWithPrec(75, 2)
+	params.CommunityPoolFraction = sdk.NewDecWithPrec(25, 2)
+	params.BlocksPerYear = 100
+	k.SetParams(ctx, params)
+
+	k.SetMinter(ctx, types.NewMinter(sdk.NewDecWithPrec(13, 2), sdk.NewDec(1000), sdk.ZeroInt()))
+	k.sk = mockStakingKeeper{
+		stakingTokenSupply: sdk.NewInt(100),
+		bondedRatio:        sdk.NewDecWithPrec(50, 2), // 50 bonded
+	}
+	k.vestingKeeper = mockVestingKeeper{vestingLocked: sdk.NewInt(20)}
+
+	// BlockProvision = 1000/100 = 10; annual staking rewards = 10*100*0.75 = 750.
+	// apy = 750 / 50 bonded = 15; effectiveAPY = 750 / (50-20) = 25.
+	resp := k.QueryStakingAPY(ctx)
+	require.True(t, resp.APY.Equal(sdk.NewDec(15)))
+	require.True(t, resp.EffectiveAPY.Equal(sdk.NewDec(25)))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// mockSupplyKeeper is a minimal types.SupplyKeeper stand-in that reports a
+// fixed total supply; only GetSupply is exercised by the tests in this
+// package.
+type mockSupplyKeeper struct {
+	total sdk.Coins
+}
+
+func (m mockSupplyKeeper) GetModuleAddress(moduleName string) sdk.AccAddress { return nil }
+func (m mockSupplyKeeper) GetSupply(ctx sdk.Context) supplyexported.SupplyI {
+	return supply.NewSupply(m.total)
+}
+func (m mockSupplyKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return nil
+}
+func (m mockSupplyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+func (m mockSupplyKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	return nil
+}
+
+// mockLockedSupplyKeeper is a minimal types.LockedSupplyKeeper stand-in that
+// reports a fixed locked supply, for exercising GetEffectiveSupply/BondedRatio.
+type mockLockedSupplyKeeper struct {
+	locked sdk.Int
+}
+
+func (m mockLockedSupplyKeeper) GetTotalLockedSupply(ctx sdk.Context, denom string) sdk.Int {
+	return m.locked
+}
+
+// mockStakingKeeper is a minimal types.StakingKeeper stand-in that reports
+// fixed staking token supply and bonded ratio figures.
+type mockStakingKeeper struct {
+	stakingTokenSupply sdk.Int
+	bondedRatio        sdk.Dec
+}
+
+func (m mockStakingKeeper) StakingTokenSupply(ctx sdk.Context) sdk.Int { return m.stakingTokenSupply }
+func (m mockStakingKeeper) BondedRatio(ctx sdk.Context) sdk.Dec        { return m.bondedRatio }
+
+// mockVestingKeeper is a minimal types.VestingKeeper stand-in that reports a
+// fixed vesting-locked amount, for exercising EffectiveBondedRatio.
+type mockVestingKeeper struct {
+	vestingLocked sdk.Int
+}
+
+func (m mockVestingKeeper) TotalVestingLocked(ctx sdk.Context, denom string) sdk.Int {
+	return m.vestingLocked
+}
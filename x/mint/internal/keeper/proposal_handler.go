@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// HandleScheduleChangeProposal implements the governance handler for a
+// ScheduleChangeProposal: it records a new ScheduleSegment so that, from
+// StartHeight onward, Keeper.CalculateCoin follows the proposal's
+// UnitCoin/Decrease/BlocksPerUnit curve instead of the genesis one.
+func HandleScheduleChangeProposal(ctx sdk.Context, k Keeper, p types.ScheduleChangeProposal) error {
+	segment := types.NewScheduleSegment(p.StartHeight, p.NewUnitCoin, p.NewDecrease, p.NewBlocksPerUnit)
+	if err := segment.Validate(); err != nil {
+		return err
+	}
+
+	k.SetScheduleSegment(ctx, segment)
+	return nil
+}
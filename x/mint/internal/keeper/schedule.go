@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// scheduleSegmentKey returns the keeper store key for the ScheduleSegment
+// starting at the given height, sorting in height order under ScheduleKey.
+func scheduleSegmentKey(startHeight int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(startHeight))
+	return append(types.ScheduleKey, b...)
+}
+
+// SetScheduleSegment stores a governance-approved halving-curve segment,
+// keyed by its StartHeight, for later lookup by Keeper.CalculateCoin.
+func (k Keeper) SetScheduleSegment(ctx sdk.Context, segment types.ScheduleSegment) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(segment)
+	store.Set(scheduleSegmentKey(segment.StartHeight), bz)
+}
+
+// GetActiveScheduleSegment returns the stored ScheduleSegment with the
+// greatest StartHeight less than or equal to height, if any have been
+// recorded via a ScheduleChangeProposal.
+func (k Keeper) GetActiveScheduleSegment(ctx sdk.Context, height int64) (segment types.ScheduleSegment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	// [ScheduleKey, scheduleSegmentKey(height+1)) contains every segment with
+	// StartHeight <= height; reverse iteration yields the greatest one first.
+	iterator := store.ReverseIterator(types.ScheduleKey, scheduleSegmentKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ScheduleSegment{}, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &segment)
+	return segment, true
+}
+
+// GetAllScheduleSegments returns every governance-approved ScheduleSegment
+// recorded via a ScheduleChangeProposal, ordered by StartHeight, for use in
+// ExportGenesis.
+func (k Keeper) GetAllScheduleSegments(ctx sdk.Context) []types.ScheduleSegment {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduleKey)
+	defer iterator.Close()
+
+	segments := []types.ScheduleSegment{}
+	for ; iterator.Valid(); iterator.Next() {
+		var segment types.ScheduleSegment
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &segment)
+		segments = append(segments, segment)
+	}
+
+	return segments
+}
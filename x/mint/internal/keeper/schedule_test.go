@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestGetActiveScheduleSegment_NoneRecorded(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	_, found := k.GetActiveScheduleSegment(ctx.WithBlockHeight(100), 100)
+	require.False(t, found)
+}
+
+func TestGetActiveScheduleSegment_Boundaries(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	early := types.NewScheduleSegment(100, sdk.NewInt(5), sdk.NewInt(90), 10)
+	late := types.NewScheduleSegment(200, sdk.NewInt(3), sdk.NewInt(80), 20)
+	k.SetScheduleSegment(ctx, early)
+	k.SetScheduleSegment(ctx, late)
+
+	// Below the first segment's start height, nothing is active yet.
+	_, found := k.GetActiveScheduleSegment(ctx, 99)
+	require.False(t, found)
+
+	// Exactly on a segment's start height, that segment is active.
+	segment, found := k.GetActiveScheduleSegment(ctx, 100)
+	require.True(t, found)
+	require.Equal(t, early, segment)
+
+	// Between the two segments, the earlier one is still active.
+	segment, found = k.GetActiveScheduleSegment(ctx, 199)
+	require.True(t, found)
+	require.Equal(t, early, segment)
+
+	// From the later segment's start height on, it takes over.
+	segment, found = k.GetActiveScheduleSegment(ctx, 200)
+	require.True(t, found)
+	require.Equal(t, late, segment)
+
+	segment, found = k.GetActiveScheduleSegment(ctx, 10000)
+	require.True(t, found)
+	require.Equal(t, late, segment)
+}
+
+func TestGetAllScheduleSegments(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+
+	require.Empty(t, k.GetAllScheduleSegments(ctx))
+
+	early := types.NewScheduleSegment(100, sdk.NewInt(5), sdk.NewInt(90), 10)
+	late := types.NewScheduleSegment(200, sdk.NewInt(3), sdk.NewInt(80), 20)
+	k.SetScheduleSegment(ctx, early)
+	k.SetScheduleSegment(ctx, late)
+
+	require.Equal(t, []types.ScheduleSegment{early, late}, k.GetAllScheduleSegments(ctx))
+}
+
+// TestCalculateCoin_SegmentChangeCapsAtRemainingSupply exercises the
+// interaction between a governance-approved ScheduleSegment and
+// NowTotalSupply: when the new segment's UnitCoin would overshoot what's
+// left of the fixed total supply, CalculateCoin must cap the minted amount
+// at the remaining supply instead of minting past it, and zero out
+// NowTotalSupply rather than leaving it negative.
+func TestCalculateCoin_SegmentChangeCapsAtRemainingSupply(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	params := k.GetParams(ctx)
+
+	remaining := sdk.NewInt(2)
+	k.SetNowTotalSupply(ctx, remaining)
+
+	segment := types.NewScheduleSegment(0, sdk.NewInt(10), sdk.NewInt(90), 1000)
+	k.SetScheduleSegment(ctx, segment)
+
+	minted := k.CalculateCoin(ctx.WithBlockHeight(0), params)
+	require.True(t, minted.Equal(remaining), "expected minted amount capped at remaining supply, got %s", minted)
+	require.True(t, k.GetNowTotalSupply(ctx).IsZero())
+
+	// Further minting at the (now exhausted) supply mints nothing more.
+	minted = k.CalculateCoin(ctx.WithBlockHeight(1), params)
+	require.True(t, minted.IsZero())
+	require.True(t, k.GetNowTotalSupply(ctx).IsZero())
+}
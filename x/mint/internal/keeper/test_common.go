@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// newTestKeeper returns a Keeper wired to an in-memory store, with no
+// StakingKeeper/SupplyKeeper dependency, for exercising the schedule and
+// halving-curve logic that only touches the mint store.
+func newTestKeeper(t *testing.T) (sdk.Context, Keeper) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	mintKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(mintKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	paramSpace := params.NewKeeper(cdc, paramsKey, tParamsKey).Subspace(types.DefaultParamspace)
+
+	k := Keeper{
+		cdc:        cdc,
+		storeKey:   mintKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+	k.SetParams(ctx, types.DefaultParams())
+
+	return ctx, k
+}
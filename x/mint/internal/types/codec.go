@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the necessary x/mint concrete types on the given
+// codec. Minter and Params need no registration since they are not used as
+// interface values; ScheduleChangeProposal is registered so it can be
+// amino-(un)marshaled as a gov.Content value.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(ScheduleChangeProposal{}, "cosmos-sdk/ScheduleChangeProposal", nil)
+}
+
+// ModuleCdc is the codec used for JSON (un)marshaling within x/mint, e.g. for
+// genesis state and governance proposal content.
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
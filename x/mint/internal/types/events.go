@@ -0,0 +1,11 @@
+package types
+
+// Minting module event types and attribute keys.
+const (
+	EventTypeMint = ModuleName
+
+	AttributeKeyBondedRatio     = "bonded_ratio"
+	AttributeKeyInflation       = "inflation"
+	AttributeKeyCycle           = "cycle"
+	AttributeKeyRemainingSupply = "remaining_supply"
+)
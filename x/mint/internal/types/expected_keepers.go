@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// StakingKeeper defines the expected staking keeper for module accounts (noalias)
+type StakingKeeper interface {
+	StakingTokenSupply(ctx sdk.Context) sdk.Int
+	BondedRatio(ctx sdk.Context) sdk.Dec
+}
+
+// LockedSupplyKeeper is an optional dependency that reports supply locked
+// outside the free-float market (e.g. an enterprise/vesting module), so it
+// can be excluded from BondedRatio and inflation-based mint provisions.
+type LockedSupplyKeeper interface {
+	GetTotalLockedSupply(ctx sdk.Context, denom string) sdk.Int
+}
+
+// VestingKeeper is an optional dependency that reports supply locked up in
+// vesting (or similar insurance-locked) accounts, counted in total/staking
+// supply but never earning staking rewards. Used by Keeper.EffectiveBondedRatio
+// to compute an honest APY for real bonded stakers.
+type VestingKeeper interface {
+	TotalVestingLocked(ctx sdk.Context, denom string) sdk.Int
+}
+
+// SupplyKeeper defines the expected supply keeper for module accounts (noalias)
+type SupplyKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
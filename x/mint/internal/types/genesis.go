@@ -0,0 +1,39 @@
+package types
+
+// GenesisState is the mint state that must be provided at genesis.
+type GenesisState struct {
+	Minter           Minter            `json:"minter" yaml:"minter"`                       // minter object
+	Params           Params            `json:"params" yaml:"params"`                       // inflation params
+	ScheduleSegments []ScheduleSegment `json:"schedule_segments" yaml:"schedule_segments"` // governance-approved halving-curve segments, see ScheduleChangeProposal
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(minter Minter, params Params, scheduleSegments []ScheduleSegment) GenesisState {
+	return GenesisState{
+		Minter:           minter,
+		Params:           params,
+		ScheduleSegments: scheduleSegments,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Minter:           DefaultInitialMinter(),
+		Params:           DefaultParams(),
+		ScheduleSegments: []ScheduleSegment{},
+	}
+}
+
+// ValidateGenesis validates the mint genesis parameters.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Minter.Validate(); err != nil {
+		return err
+	}
+	for _, segment := range data.ScheduleSegments {
+		if err := segment.Validate(); err != nil {
+			return err
+		}
+	}
+	return data.Params.Validate()
+}
@@ -3,6 +3,11 @@ package types
 // MinterKey is used for the keeper store
 var MinterKey = []byte{0x00}
 
+// ScheduleKey prefixes height-indexed ScheduleSegment entries in the keeper
+// store, one per governance-approved halving-curve change (see
+// ScheduleChangeProposal).
+var ScheduleKey = []byte{0x01}
+
 // nolint
 const (
 	// ModuleName
@@ -17,6 +22,9 @@ const (
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
+	// RouterKey is the governance message/proposal route for the minting module.
+	RouterKey = ModuleName
+
 	NowTotalSupply = "nowTotalSupply"
 
 	// Query endpoints supported by the minting querier
@@ -24,4 +32,5 @@ const (
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
 	QueryNowTotalSupply   = "now_total_supply"
-)
\ No newline at end of file
+	QueryStakingAPY       = "staking_apy"
+)
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Minting modes supported by the mint module, selected via Params.MintingMode.
+const (
+	// MintingModeHalving is the original fixed-cap schedule driven by
+	// UnitCoin/Decrease/BlocksPerUnit (see Keeper.CalculateCoin).
+	MintingModeHalving = "halving"
+
+	// MintingModeTarget is a supply-elastic schedule: every time total supply
+	// reaches Minter.TargetSupply, the inflation rate and target supply are
+	// rolled forward and block provisions are minted against AnnualProvisions.
+	MintingModeTarget = "target"
+)
+
+// Minter represents the minting state.
+type Minter struct {
+	Inflation        sdk.Dec `json:"inflation" yaml:"inflation"`                 // current annual inflation rate, used in MintingModeTarget
+	AnnualProvisions sdk.Dec `json:"annual_provisions" yaml:"annual_provisions"` // current annual expected provisions, used in MintingModeTarget
+	TargetSupply     sdk.Int `json:"target_supply" yaml:"target_supply"`         // total supply at which the next inflation roll happens, used in MintingModeTarget
+}
+
+// NewMinter returns a new Minter object with the given inflation, annual
+// provisions and target supply.
+func NewMinter(inflation, annualProvisions sdk.Dec, targetSupply sdk.Int) Minter {
+	return Minter{
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+		TargetSupply:     targetSupply,
+	}
+}
+
+// InitialMinter returns an initial Minter object with a given inflation
+// value and zeroed out annual provisions/target supply, to be rolled on the
+// first BeginBlocker call in MintingModeTarget.
+func InitialMinter(inflation sdk.Dec) Minter {
+	return NewMinter(inflation, sdk.ZeroDec(), sdk.ZeroInt())
+}
+
+// DefaultInitialMinter returns a default initial Minter object for a new chain,
+// with an inflation rate of 13%.
+func DefaultInitialMinter() Minter {
+	return InitialMinter(sdk.NewDecWithPrec(13, 2))
+}
+
+// Validate returns an error if the Minter is invalid.
+func (m Minter) Validate() error {
+	if m.Inflation.IsNegative() {
+		return fmt.Errorf("mint parameter Inflation should be positive, is %s", m.Inflation.String())
+	}
+	if m.AnnualProvisions.IsNegative() {
+		return fmt.Errorf("mint parameter AnnualProvisions should be positive, is %s", m.AnnualProvisions.String())
+	}
+	if m.TargetSupply.IsNegative() {
+		return fmt.Errorf("mint parameter TargetSupply should be positive, is %s", m.TargetSupply.String())
+	}
+	return nil
+}
+
+func (m Minter) String() string {
+	return fmt.Sprintf(`Minter:
+  Inflation:          %s
+  Annual Provisions:  %s
+  Target Supply:      %s
+`,
+		m.Inflation, m.AnnualProvisions, m.TargetSupply,
+	)
+}
+
+// NextInflationRate returns the new inflation rate for MintingModeTarget,
+// clamping the change between years to InflationRateChange and the result to
+// [InflationMin, InflationMax] based on how far BondedRatio is from GoalBonded.
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+
+	inflation := m.Inflation.Add(inflationRateChangePerYear)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// NextAnnualProvisions returns the annual provisions based on current total
+// supply and inflation rate.
+func (m Minter) NextAnnualProvisions(totalSupply sdk.Int) sdk.Dec {
+	return m.Inflation.MulInt(totalSupply)
+}
+
+// BlockProvision returns the provisions for a block based on the annual
+// provisions rate, used in MintingModeTarget.
+func (m Minter) BlockProvision(params Params) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func testParams() Params {
+	params := DefaultParams()
+	params.MintingMode = MintingModeTarget
+	params.GoalBonded = sdk.NewDecWithPrec(67, 2)
+	params.InflationRateChange = sdk.NewDecWithPrec(13, 2)
+	params.InflationMax = sdk.NewDecWithPrec(20, 2)
+	params.InflationMin = sdk.NewDecWithPrec(7, 2)
+	params.BlocksPerYear = 6311520
+	return params
+}
+
+func TestNextInflationRate_ClampsToRange(t *testing.T) {
+	params := testParams()
+
+	// Bonded ratio well below GoalBonded pushes inflation toward InflationMax.
+	minter := NewMinter(params.InflationMax, sdk.ZeroDec(), sdk.ZeroInt())
+	inflation := minter.NextInflationRate(params, sdk.ZeroDec())
+	require.True(t, inflation.Equal(params.InflationMax))
+
+	// Bonded ratio well above GoalBonded pushes inflation toward InflationMin.
+	minter = NewMinter(params.InflationMin, sdk.ZeroDec(), sdk.ZeroInt())
+	inflation = minter.NextInflationRate(params, sdk.OneDec())
+	require.True(t, inflation.Equal(params.InflationMin))
+}
+
+func TestNextAnnualProvisions(t *testing.T) {
+	minter := NewMinter(sdk.NewDecWithPrec(13, 2), sdk.ZeroDec(), sdk.ZeroInt())
+
+	provisions := minter.NextAnnualProvisions(sdk.NewInt(1000000))
+	require.True(t, provisions.Equal(sdk.NewDec(130000)))
+}
+
+func TestBlockProvision(t *testing.T) {
+	params := testParams()
+	params.BlocksPerYear = 100
+
+	minter := NewMinter(sdk.NewDecWithPrec(13, 2), sdk.NewDec(1000), sdk.ZeroInt())
+
+	provision := minter.BlockProvision(params)
+	require.Equal(t, params.MintDenom, provision.Denom)
+	require.True(t, provision.Amount.Equal(sdk.NewInt(10)))
+}
@@ -12,16 +12,20 @@ import (
 
 // Parameter store keys
 var (
-	KeyMintDenom           = []byte("MintDenom")
-	KeyInflationRateChange = []byte("InflationRateChange")
-	KeyInflationMax        = []byte("InflationMax")
-	KeyInflationMin        = []byte("InflationMin")
-	KeyGoalBonded          = []byte("GoalBonded")
-	KeyBlocksPerYear       = []byte("BlocksPerYear")
-	KeyTotalSupply         = []byte("TotalSupply")
-	KeyBlocksPerUnit       = []byte("BlocksPerUnit")
-	KeyUnitCoin            = []byte("UnitCoin")
-	KeyDecrease            = []byte("Decrease")
+	KeyMintDenom                  = []byte("MintDenom")
+	KeyInflationRateChange        = []byte("InflationRateChange")
+	KeyInflationMax               = []byte("InflationMax")
+	KeyInflationMin               = []byte("InflationMin")
+	KeyGoalBonded                 = []byte("GoalBonded")
+	KeyBlocksPerYear              = []byte("BlocksPerYear")
+	KeyTotalSupply                = []byte("TotalSupply")
+	KeyBlocksPerUnit              = []byte("BlocksPerUnit")
+	KeyUnitCoin                   = []byte("UnitCoin")
+	KeyDecrease                   = []byte("Decrease")
+	KeyMintingMode                = []byte("MintingMode")
+	KeyStakingRewardsFraction     = []byte("StakingRewardsFraction")
+	KeyCommunityPoolFraction      = []byte("CommunityPoolFraction")
+	KeyExcludeLockedFromInflation = []byte("ExcludeLockedFromInflation")
 )
 
 // mint parameters
@@ -36,6 +40,18 @@ type Params struct {
 	BlocksPerUnit       int64   `json:"blocks_per_unit" yaml:"blocks_per_unit"`             // blocks per unit, includes year、month、day、hour
 	UnitCoin            sdk.Int `json:"unit_coin" yaml:"unit_coin"`                         // unit coin
 	Decrease            sdk.Int `json:"decrease" yaml:"decrease"`                           //decrease
+	MintingMode         string  `json:"minting_mode" yaml:"minting_mode"`                   // "halving" (fixed-cap UnitCoin/Decrease schedule) or "target" (supply-elastic TargetSupply schedule)
+
+	// StakingRewardsFraction and CommunityPoolFraction split each block's minted
+	// coins between the staking fee collector and the community pool module
+	// account; the two must sum to one.
+	StakingRewardsFraction sdk.Dec `json:"staking_rewards_fraction" yaml:"staking_rewards_fraction"`
+	CommunityPoolFraction  sdk.Dec `json:"community_pool_fraction" yaml:"community_pool_fraction"`
+
+	// ExcludeLockedFromInflation, when true and a LockedSupplyKeeper is wired
+	// on the mint Keeper, excludes locked/enterprise supply from BondedRatio
+	// and from the base used for inflation-based mint provisions.
+	ExcludeLockedFromInflation bool `json:"exclude_locked_from_inflation" yaml:"exclude_locked_from_inflation"`
 }
 
 // ParamTable for minting module.
@@ -44,36 +60,45 @@ func ParamKeyTable() params.KeyTable {
 }
 
 func NewParams(
-	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64, totalSupply sdk.Int, blocksPerUnit int64, unitCoin sdk.Int, decrease sdk.Int,
+	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64, totalSupply sdk.Int, blocksPerUnit int64, unitCoin sdk.Int, decrease sdk.Int, mintingMode string,
+	stakingRewardsFraction, communityPoolFraction sdk.Dec, excludeLockedFromInflation bool,
 ) Params {
 
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
-		TotalSupply:         totalSupply,
-		BlocksPerUnit:       blocksPerUnit,
-		UnitCoin:            unitCoin,
-		Decrease:            decrease,
+		MintDenom:                  mintDenom,
+		InflationRateChange:        inflationRateChange,
+		InflationMax:               inflationMax,
+		InflationMin:               inflationMin,
+		GoalBonded:                 goalBonded,
+		BlocksPerYear:              blocksPerYear,
+		TotalSupply:                totalSupply,
+		BlocksPerUnit:              blocksPerUnit,
+		UnitCoin:                   unitCoin,
+		Decrease:                   decrease,
+		MintingMode:                mintingMode,
+		StakingRewardsFraction:     stakingRewardsFraction,
+		CommunityPoolFraction:      communityPoolFraction,
+		ExcludeLockedFromInflation: excludeLockedFromInflation,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5),                         // assuming 5 second block times
-		TotalSupply:         sdk.NewInt(21000000).MulRaw(int64(math.Pow10(18))), //total supply
-		BlocksPerUnit:       int64(17820),
-		UnitCoin:            sdk.NewInt(1).MulRaw(int64(math.Pow10(18))),
-		Decrease:            sdk.NewInt(90),
+		MintDenom:                  sdk.DefaultBondDenom,
+		InflationRateChange:        sdk.NewDecWithPrec(13, 2),
+		InflationMax:               sdk.NewDecWithPrec(20, 2),
+		InflationMin:               sdk.NewDecWithPrec(7, 2),
+		GoalBonded:                 sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:              uint64(60 * 60 * 8766 / 5),                         // assuming 5 second block times
+		TotalSupply:                sdk.NewInt(21000000).MulRaw(int64(math.Pow10(18))), //total supply
+		BlocksPerUnit:              int64(17820),
+		UnitCoin:                   sdk.NewInt(1).MulRaw(int64(math.Pow10(18))),
+		Decrease:                   sdk.NewInt(90),
+		MintingMode:                MintingModeHalving,
+		StakingRewardsFraction:     sdk.NewDecWithPrec(75, 2),
+		CommunityPoolFraction:      sdk.NewDecWithPrec(25, 2),
+		ExcludeLockedFromInflation: false,
 	}
 }
 
@@ -109,6 +134,24 @@ func (p Params) Validate() error {
 	if err := validateDecrease(p.UnitCoin); err != nil {
 		return err
 	}
+	if err := validateMintingMode(p.MintingMode); err != nil {
+		return err
+	}
+	if err := validateFraction(p.StakingRewardsFraction); err != nil {
+		return err
+	}
+	if err := validateFraction(p.CommunityPoolFraction); err != nil {
+		return err
+	}
+	if err := validateExcludeLockedFromInflation(p.ExcludeLockedFromInflation); err != nil {
+		return err
+	}
+	if !p.StakingRewardsFraction.Add(p.CommunityPoolFraction).Equal(sdk.OneDec()) {
+		return fmt.Errorf(
+			"staking rewards fraction (%s) and community pool fraction (%s) must sum to 1",
+			p.StakingRewardsFraction, p.CommunityPoolFraction,
+		)
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -128,9 +171,13 @@ func (p Params) String() string {
   Inflation Min:          %s
   Goal Bonded:            %s
   Blocks Per Year:        %d
+  Minting Mode:           %s
+  Staking Rewards Frac:   %s
+  Community Pool Frac:    %s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax,
-		p.InflationMin, p.GoalBonded, p.BlocksPerYear,
+		p.InflationMin, p.GoalBonded, p.BlocksPerYear, p.MintingMode,
+		p.StakingRewardsFraction, p.CommunityPoolFraction,
 	)
 }
 
@@ -147,6 +194,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyBlocksPerUnit, &p.BlocksPerUnit, validateBlocksPerUnit),
 		params.NewParamSetPair(KeyUnitCoin, &p.UnitCoin, validateUnitCoin),
 		params.NewParamSetPair(KeyDecrease, &p.Decrease, validateDecrease),
+		params.NewParamSetPair(KeyMintingMode, &p.MintingMode, validateMintingMode),
+		params.NewParamSetPair(KeyStakingRewardsFraction, &p.StakingRewardsFraction, validateFraction),
+		params.NewParamSetPair(KeyCommunityPoolFraction, &p.CommunityPoolFraction, validateFraction),
+		params.NewParamSetPair(KeyExcludeLockedFromInflation, &p.ExcludeLockedFromInflation, validateExcludeLockedFromInflation),
 	}
 }
 
@@ -302,3 +353,41 @@ func validateDecrease(i interface{}) error {
 
 	return nil
 }
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateExcludeLockedFromInflation(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMintingMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case MintingModeHalving, MintingModeTarget:
+		return nil
+	default:
+		return fmt.Errorf("minting mode must be %q or %q, got: %s", MintingModeHalving, MintingModeTarget, v)
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryAPYResponse is the response type for the staking_apy query. APY is the
+// raw annualized yield delivered to bonded stakers; EffectiveAPY divides the
+// same annual rewards by bonded tokens net of vesting/insurance-locked
+// tokens (see Keeper.EffectiveBondedRatio), so wallets can show a number that
+// isn't diluted by tokens that never actually earn rewards.
+type QueryAPYResponse struct {
+	APY          sdk.Dec `json:"apy" yaml:"apy"`
+	EffectiveAPY sdk.Dec `json:"effective_apy" yaml:"effective_apy"`
+}
+
+// NewQueryAPYResponse creates a new QueryAPYResponse instance.
+func NewQueryAPYResponse(apy, effectiveAPY sdk.Dec) QueryAPYResponse {
+	return QueryAPYResponse{
+		APY:          apy,
+		EffectiveAPY: effectiveAPY,
+	}
+}
+
+func (r QueryAPYResponse) String() string {
+	return fmt.Sprintf("APY: %s\nEffective APY: %s\n", r.APY, r.EffectiveAPY)
+}
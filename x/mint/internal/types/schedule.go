@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleSegment is a halving-curve segment stored under ScheduleKey,
+// created by a governance-approved ScheduleChangeProposal. Keeper.CalculateCoin
+// looks up the segment with the greatest StartHeight <= the current block
+// height and computes emissions against that segment's curve, so emissions
+// already paid out under earlier segments remain intact.
+type ScheduleSegment struct {
+	StartHeight   int64   `json:"start_height" yaml:"start_height"`
+	UnitCoin      sdk.Int `json:"unit_coin" yaml:"unit_coin"`
+	Decrease      sdk.Int `json:"decrease" yaml:"decrease"`
+	BlocksPerUnit int64   `json:"blocks_per_unit" yaml:"blocks_per_unit"`
+}
+
+// NewScheduleSegment creates a new ScheduleSegment.
+func NewScheduleSegment(startHeight int64, unitCoin, decrease sdk.Int, blocksPerUnit int64) ScheduleSegment {
+	return ScheduleSegment{
+		StartHeight:   startHeight,
+		UnitCoin:      unitCoin,
+		Decrease:      decrease,
+		BlocksPerUnit: blocksPerUnit,
+	}
+}
+
+// Validate returns an error if the segment is invalid.
+func (s ScheduleSegment) Validate() error {
+	if s.StartHeight < 0 {
+		return fmt.Errorf("schedule segment start height cannot be negative: %d", s.StartHeight)
+	}
+	if s.UnitCoin.IsNil() || !s.UnitCoin.IsPositive() {
+		return fmt.Errorf("schedule segment unit coin must be positive: %s", s.UnitCoin)
+	}
+	if s.Decrease.IsNil() || !s.Decrease.IsPositive() {
+		return fmt.Errorf("schedule segment decrease must be positive: %s", s.Decrease)
+	}
+	if s.BlocksPerUnit <= 0 {
+		return fmt.Errorf("schedule segment blocks per unit must be positive: %d", s.BlocksPerUnit)
+	}
+	return nil
+}
+
+func (s ScheduleSegment) String() string {
+	return fmt.Sprintf(`ScheduleSegment:
+  Start Height:     %d
+  Unit Coin:        %s
+  Decrease:         %s
+  Blocks Per Unit:  %d
+`,
+		s.StartHeight, s.UnitCoin, s.Decrease, s.BlocksPerUnit,
+	)
+}
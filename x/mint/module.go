@@ -0,0 +1,124 @@
+package mint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/mint/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the mint module.
+type AppModuleBasic struct{}
+
+// Name returns the mint module's name.
+func (AppModuleBasic) Name() string { return ModuleName }
+
+// RegisterCodec registers the mint module's types, including the
+// ScheduleChangeProposal gov.Content implementation, on the codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the mint module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the mint module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return ValidateGenesis(data)
+}
+
+// RegisterRESTRoutes registers the mint module's REST service handlers.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {}
+
+// GetTxCmd returns the mint module's root tx command. The mint module has no
+// user transactions.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command { return nil }
+
+// GetQueryCmd returns the mint module's root query command.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
+
+// AppModule implements the sdk.AppModule interface for the mint module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper       Keeper
+	supplyKeeper SupplyKeeper
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(keeper Keeper, supplyKeeper SupplyKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+		supplyKeeper:   supplyKeeper,
+	}
+}
+
+// Name returns the mint module's name.
+func (AppModule) Name() string { return ModuleName }
+
+// RegisterInvariants registers the mint module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
+
+// Route returns an empty module message route; the mint module has no
+// messages, only a governance proposal handler (see NewScheduleChangeProposalHandler).
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns nil; the mint module has no messages.
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the mint module's querier route name.
+func (AppModule) QuerierRoute() string { return QuerierRoute }
+
+// NewQuerierHandler returns the mint module's sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// InitGenesis performs genesis initialization for the mint module.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc *codec.Codec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genesisState)
+	InitGenesis(ctx, am.keeper, am.supplyKeeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the mint module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc *codec.Codec) json.RawMessage {
+	return cdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
+}
+
+// BeginBlock mints new tokens for the previous block.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
+// EndBlock returns no validator updates for the mint module.
+func (AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}